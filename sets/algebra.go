@@ -0,0 +1,83 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sets
+
+// Union returns a new Set, constructed via set1's New(), containing every
+// element present in set1 or in any of the other given sets.
+func Union(set1 Set, others ...Set) Set {
+	result := set1.New()
+	result.Add(set1.Values()...)
+	for _, other := range others {
+		result.Add(other.Values()...)
+	}
+	return result
+}
+
+// Intersection returns a new Set, constructed via set1's New(), containing
+// only the elements present in set1 and in every one of the other given sets.
+func Intersection(set1 Set, others ...Set) Set {
+	result := set1.New()
+	for _, item := range set1.Values() {
+		inAll := true
+		for _, other := range others {
+			if !other.Contains(item) {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Difference returns a new Set, constructed via set1's New(), containing
+// the elements of set1 that are not present in any of the other given sets.
+func Difference(set1 Set, others ...Set) Set {
+	result := set1.New()
+	for _, item := range set1.Values() {
+		excluded := false
+		for _, other := range others {
+			if other.Contains(item) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new Set, constructed via set1's New(),
+// containing the elements that belong to exactly one of set1 and set2.
+func SymmetricDifference(set1, set2 Set) Set {
+	result := set1.New()
+	result.Add(Difference(set1, set2).Values()...)
+	result.Add(Difference(set2, set1).Values()...)
+	return result
+}
+
+// IsSubset returns true if every element of set1 is present in set2.
+func IsSubset(set1, set2 Set) bool {
+	for _, item := range set1.Values() {
+		if !set2.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if set1 contains every element of set2.
+func IsSuperset(set1, set2 Set) bool {
+	return IsSubset(set2, set1)
+}
+
+// IsEqual returns true if set1 and set2 contain exactly the same elements.
+func IsEqual(set1, set2 Set) bool {
+	return set1.Size() == set2.Size() && IsSubset(set1, set2)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sets_test
+
+import (
+	"testing"
+
+	"github.com/emirpasic/gods/sets"
+	"github.com/emirpasic/gods/sets/hashset"
+)
+
+func newSet(items ...interface{}) sets.Set {
+	s := hashset.New()
+	s.Add(items...)
+	return s
+}
+
+func TestUnion(t *testing.T) {
+	a := newSet(1, 2)
+	b := newSet(2, 3)
+
+	got := sets.Union(a, b)
+
+	if !got.Contains(1, 2, 3) || got.Size() != 3 {
+		t.Fatalf("Union(a, b) = %v, want {1, 2, 3}", got.Values())
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	a := newSet(1, 2, 3)
+	b := newSet(2, 3, 4)
+
+	got := sets.Intersection(a, b)
+
+	if !got.Contains(2, 3) || got.Size() != 2 {
+		t.Fatalf("Intersection(a, b) = %v, want {2, 3}", got.Values())
+	}
+}
+
+func TestDifference(t *testing.T) {
+	a := newSet(1, 2, 3)
+	b := newSet(2, 3)
+
+	got := sets.Difference(a, b)
+
+	if !got.Contains(1) || got.Size() != 1 {
+		t.Fatalf("Difference(a, b) = %v, want {1}", got.Values())
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	a := newSet(1, 2, 3)
+	b := newSet(2, 3, 4)
+
+	got := sets.SymmetricDifference(a, b)
+
+	if !got.Contains(1, 4) || got.Size() != 2 {
+		t.Fatalf("SymmetricDifference(a, b) = %v, want {1, 4}", got.Values())
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	a := newSet(1, 2)
+	b := newSet(1, 2, 3)
+
+	if !sets.IsSubset(a, b) {
+		t.Error("IsSubset(a, b) = false, want true")
+	}
+	if sets.IsSubset(b, a) {
+		t.Error("IsSubset(b, a) = true, want false")
+	}
+}
+
+func TestIsSuperset(t *testing.T) {
+	a := newSet(1, 2, 3)
+	b := newSet(1, 2)
+
+	if !sets.IsSuperset(a, b) {
+		t.Error("IsSuperset(a, b) = false, want true")
+	}
+	if sets.IsSuperset(b, a) {
+		t.Error("IsSuperset(b, a) = true, want false")
+	}
+}
+
+func TestIsEqual(t *testing.T) {
+	a := newSet(1, 2, 3)
+	b := newSet(3, 2, 1)
+	c := newSet(1, 2)
+
+	if !sets.IsEqual(a, b) {
+		t.Error("IsEqual(a, b) = false, want true")
+	}
+	if sets.IsEqual(a, c) {
+		t.Error("IsEqual(a, c) = true, want false")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command gen emits a typed set file for a comparable go type, in the style
+// of StringSet, IntSet, Int64Set and ByteSet. Run it from sets/hashset:
+//
+//	go run gen/main.go -type=rune -name=RuneSet -sort=sort.Slice > rune.go
+//
+// -sort names the sort call used by List(): "sort.Strings", "sort.Ints", or
+// "sort.Slice" (emitted as a less-than comparison) for any other ordered type.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("typedset").Parse(`// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by go run gen/main.go -type={{.Type}} -name={{.Name}} -sort={{.Sort}}; DO NOT EDIT.
+
+package hashset
+
+import "sort"
+
+// {{.Name}} is a set of {{.Type}}s, backed by a plain go map. Unlike Set, it
+// stores its elements unboxed, avoiding interface{} allocation and runtime
+// type assertions in hot paths.
+type {{.Name}} map[{{.Type}}]struct{}
+
+// New{{.Name}} creates a {{.Name}} from a list of values.
+func New{{.Name}}(items ...{{.Type}}) {{.Name}} {
+	s := {{.Name}}{}
+	s.Insert(items...)
+	return s
+}
+
+// Insert adds items to the set.
+func (s {{.Name}}) Insert(items ...{{.Type}}) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Delete removes items from the set.
+func (s {{.Name}}) Delete(items ...{{.Type}}) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Has returns true if item is contained in the set.
+func (s {{.Name}}) Has(item {{.Type}}) bool {
+	_, contained := s[item]
+	return contained
+}
+
+// HasAll returns true if all items are contained in the set.
+func (s {{.Name}}) HasAll(items ...{{.Type}}) bool {
+	for _, item := range items {
+		if !s.Has(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny returns true if any of the items is contained in the set.
+func (s {{.Name}}) HasAny(items ...{{.Type}}) bool {
+	for _, item := range items {
+		if s.Has(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Difference returns the set of elements in s that are not in s2.
+func (s {{.Name}}) Difference(s2 {{.Name}}) {{.Name}} {
+	result := New{{.Name}}()
+	for item := range s {
+		if !s2.Has(item) {
+			result.Insert(item)
+		}
+	}
+	return result
+}
+
+// Union returns the set of elements in either s or s2.
+func (s {{.Name}}) Union(s2 {{.Name}}) {{.Name}} {
+	result := New{{.Name}}()
+	for item := range s {
+		result.Insert(item)
+	}
+	for item := range s2 {
+		result.Insert(item)
+	}
+	return result
+}
+
+// Intersection returns the set of elements in both s and s2.
+func (s {{.Name}}) Intersection(s2 {{.Name}}) {{.Name}} {
+	result := New{{.Name}}()
+	for item := range s {
+		if s2.Has(item) {
+			result.Insert(item)
+		}
+	}
+	return result
+}
+
+// List returns the contents as a sorted {{.Type}} slice.
+func (s {{.Name}}) List() []{{.Type}} {
+	res := make([]{{.Type}}, 0, len(s))
+	for item := range s {
+		res = append(res, item)
+	}
+	{{.SortCall}}
+	return res
+}
+
+// Len returns the number of elements in the set.
+func (s {{.Name}}) Len() int {
+	return len(s)
+}
+`))
+
+type data struct {
+	Type     string
+	Name     string
+	Sort     string
+	SortCall string
+}
+
+func main() {
+	typ := flag.String("type", "", "element go type, e.g. string, int, rune")
+	name := flag.String("name", "", "generated set type name, e.g. RuneSet")
+	sortFn := flag.String("sort", "sort.Slice", "sort call: sort.Strings, sort.Ints, or sort.Slice")
+	flag.Parse()
+
+	if *typ == "" || *name == "" {
+		log.Fatal("both -type and -name are required")
+	}
+
+	sortCall := *sortFn + "(res, func(i, j int) bool { return res[i] < res[j] })"
+	switch *sortFn {
+	case "sort.Strings", "sort.Ints":
+		sortCall = *sortFn + "(res)"
+	}
+
+	if err := tmpl.Execute(os.Stdout, data{Type: *typ, Name: *name, Sort: *sortFn, SortCall: sortCall}); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+//go:generate sh -c "go run gen/main.go -type=string -name=StringSet -sort=sort.Strings > string.go"
+//go:generate sh -c "go run gen/main.go -type=int -name=IntSet -sort=sort.Ints > int.go"
+//go:generate sh -c "go run gen/main.go -type=int64 -name=Int64Set -sort=sort.Slice > int64.go"
+//go:generate sh -c "go run gen/main.go -type=byte -name=ByteSet -sort=sort.Slice > byte.go"
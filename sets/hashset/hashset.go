@@ -4,14 +4,22 @@
 
 // Package hashset implements a set backed by a hash table.
 //
-// Structure is not thread safe.
+// Set is not thread safe. For concurrent use, see the SetTS sibling type,
+// constructed with NewThreadSafe.
 //
 // References: http://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
 package hashset
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/emirpasic/gods/sets"
 )
@@ -32,6 +40,11 @@ func New() *Set {
 	return &Set{items: make(map[interface{}]struct{})}
 }
 
+// New returns a new empty set, satisfying sets.Set's New() factory method.
+func (set *Set) New() sets.Set {
+	return New()
+}
+
 // Add adds the items (one or more) to the set.
 func (set *Set) Add(items ...interface{}) {
 	for _, item := range items {
@@ -82,7 +95,7 @@ func (set *Set) Intersection(other sets.Set) sets.Set {
 // Union returns the set of elements of both sets.
 func (set *Set) Union(other sets.Set) sets.Set {
 	union := New()
-	union.Add(set.items)
+	union.Add(set.Values()...)
 	union.Add(other.Values()...)
 	return union
 }
@@ -98,6 +111,60 @@ func (set *Set) Subtract(other sets.Set) sets.Set {
 	return diff
 }
 
+// Pop removes and returns an arbitrary element from the set. The second
+// return value is false if the set was empty.
+func (set *Set) Pop() (interface{}, bool) {
+	for item := range set.items {
+		delete(set.items, item)
+		return item, true
+	}
+	return nil, false
+}
+
+// Copy returns a shallow clone of the set.
+func (set *Set) Copy() *Set {
+	clone := New()
+	clone.Add(set.Values()...)
+	return clone
+}
+
+// Merge adds every element of other to the set in place, acting as an
+// in-place union.
+func (set *Set) Merge(other sets.Set) {
+	set.Add(other.Values()...)
+}
+
+// Separate removes every element of other from the set in place, acting as
+// an in-place difference.
+func (set *Set) Separate(other sets.Set) {
+	set.Remove(other.Values()...)
+}
+
+// Equal returns true if set and other contain exactly the same elements.
+func (set *Set) Equal(other sets.Set) bool {
+	return set.Size() == other.Size() && set.IsSubset(other)
+}
+
+// IsSubset returns true if every element of set is present in other.
+func (set *Set) IsSubset(other sets.Set) bool {
+	for k := range set.items {
+		if !other.Contains(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if set contains every element of other.
+func (set *Set) IsSuperset(other sets.Set) bool {
+	for _, v := range other.Values() {
+		if !set.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
 // Empty returns true if set does not contain any elements.
 func (set *Set) Empty() bool {
 	return set.Size() == 0
@@ -124,6 +191,83 @@ func (set *Set) Values() []interface{} {
 	return values
 }
 
+// Iterator returns a channel-based iterator over a snapshot of the set's
+// elements taken before the producing goroutine starts, so a caller mutating
+// the set after receiving the Iterator does not race with it. The producing
+// goroutine leaks if the returned Iterator's C channel is not drained to
+// completion, so callers that stop early must call Stop.
+func (set *Set) Iterator() *sets.Iterator {
+	items := set.Values()
+	c := make(chan interface{})
+	stop := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		defer close(c)
+		for _, item := range items {
+			select {
+			case c <- item:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return &sets.Iterator{
+		C:    c,
+		Stop: func() { once.Do(func() { close(stop) }) },
+	}
+}
+
+// Each calls f for every item in the set, stopping early if f returns false.
+func (set *Set) Each(f func(item interface{}) bool) {
+	for item := range set.items {
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// Any returns true if f returns true for at least one item in the set.
+func (set *Set) Any(f func(item interface{}) bool) bool {
+	for item := range set.items {
+		if f(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if f returns true for every item in the set.
+func (set *Set) All(f func(item interface{}) bool) bool {
+	for item := range set.items {
+		if !f(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a new set containing only the items for which f returns true.
+func (set *Set) Filter(f func(item interface{}) bool) sets.Set {
+	result := New()
+	for item := range set.items {
+		if f(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Map returns a new set containing the result of applying f to every item.
+func (set *Set) Map(f func(item interface{}) interface{}) sets.Set {
+	result := New()
+	for item := range set.items {
+		result.Add(f(item))
+	}
+	return result
+}
+
 // String returns a string representation of container
 func (set *Set) String() string {
 	str := "HashSet\n"
@@ -134,3 +278,111 @@ func (set *Set) String() string {
 	str += strings.Join(items, ", ")
 	return str
 }
+
+// MarshalJSON marshals the set into a JSON array. Elements are sorted first
+// when they are all strings or all of the same orderable numeric type, so
+// that the output is deterministic; otherwise they appear in map order.
+func (set *Set) MarshalJSON() ([]byte, error) {
+	items := set.Values()
+	sortComparableValues(items)
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON reconstructs the set from a JSON array, replacing any
+// existing elements. encoding/json decodes every JSON number as float64, so
+// whole numbers are converted back to int to round-trip sets of ints built
+// with literal values (the common case). A set whose elements were int64
+// rather than int will come back as a set of int, since the two are
+// indistinguishable once serialized as JSON numbers.
+func (set *Set) UnmarshalJSON(data []byte) error {
+	var raw []interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	items := make([]interface{}, len(raw))
+	for i, item := range raw {
+		items[i] = normalizeJSONNumber(item)
+	}
+	set.Clear()
+	set.Add(items...)
+	return nil
+}
+
+// normalizeJSONNumber converts a json.Unmarshal-produced float64 back to an
+// int when it represents a whole number, so that callers who Add(1, 2, 3)
+// see those same int values after a MarshalJSON/UnmarshalJSON round-trip.
+func normalizeJSONNumber(item interface{}) interface{} {
+	f, ok := item.(float64)
+	if !ok || f != math.Trunc(f) {
+		return item
+	}
+	return int(f)
+}
+
+// GobEncode encodes the set as a gob-encoded slice of its elements, sorted
+// the same way as MarshalJSON. Concrete element types must be registered
+// with gob.Register beforehand, as is standard for encoding interface{}
+// values.
+func (set *Set) GobEncode() ([]byte, error) {
+	items := set.Values()
+	sortComparableValues(items)
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode reconstructs the set from data produced by GobEncode, replacing
+// any existing elements.
+func (set *Set) GobDecode(data []byte) error {
+	var items []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	set.Clear()
+	set.Add(items...)
+	return nil
+}
+
+// sortComparableValues sorts items in place when they are all strings or all
+// of the same orderable numeric type, giving deterministic output for the
+// common case. Mixed or non-orderable element types are left untouched.
+func sortComparableValues(items []interface{}) {
+	if len(items) < 2 {
+		return
+	}
+	first := reflect.TypeOf(items[0])
+	for _, item := range items[1:] {
+		if reflect.TypeOf(item) != first {
+			return
+		}
+	}
+
+	switch items[0].(type) {
+	case string:
+		sort.Slice(items, func(i, j int) bool {
+			a, aok := items[i].(string)
+			b, bok := items[j].(string)
+			return aok && bok && a < b
+		})
+	case int:
+		sort.Slice(items, func(i, j int) bool {
+			a, aok := items[i].(int)
+			b, bok := items[j].(int)
+			return aok && bok && a < b
+		})
+	case int64:
+		sort.Slice(items, func(i, j int) bool {
+			a, aok := items[i].(int64)
+			b, bok := items[j].(int64)
+			return aok && bok && a < b
+		})
+	case float64:
+		sort.Slice(items, func(i, j int) bool {
+			a, aok := items[i].(float64)
+			b, bok := items[j].(float64)
+			return aok && bok && a < b
+		})
+	}
+}
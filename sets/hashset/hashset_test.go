@@ -0,0 +1,168 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"encoding/gob"
+	"testing"
+)
+
+func TestSetJSONRoundTripInts(t *testing.T) {
+	set := New()
+	set.Add(1, 2, 3)
+
+	data, err := set.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	got := New()
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	if !got.Contains(1, 2, 3) {
+		t.Fatalf("got.Contains(1, 2, 3) = false after round-trip, got %v", got.Values())
+	}
+	if !set.Equal(got) {
+		t.Fatalf("set.Equal(got) = false after round-trip: set=%v got=%v", set.Values(), got.Values())
+	}
+}
+
+func TestSetJSONRoundTripStrings(t *testing.T) {
+	set := New()
+	set.Add("a", "b", "c")
+
+	data, err := set.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	got := New()
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	if !set.Equal(got) {
+		t.Fatalf("set.Equal(got) = false after round-trip: set=%v got=%v", set.Values(), got.Values())
+	}
+}
+
+func TestSetGobRoundTrip(t *testing.T) {
+	gob.Register(0)
+
+	set := New()
+	set.Add(1, 2, 3)
+
+	data, err := set.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode: %v", err)
+	}
+
+	got := New()
+	if err := got.GobDecode(data); err != nil {
+		t.Fatalf("GobDecode: %v", err)
+	}
+
+	if !set.Equal(got) {
+		t.Fatalf("set.Equal(got) = false after round-trip: set=%v got=%v", set.Values(), got.Values())
+	}
+}
+
+func TestSetIteratorSnapshotsBeforeMutation(t *testing.T) {
+	set := New()
+	set.Add(1, 2, 3)
+
+	it := set.Iterator()
+	<-it.C
+	// Mutating the set after Iterator has returned must not race with the
+	// background goroutine draining it; it was started over a snapshot.
+	set.Add(4)
+	for range it.C {
+	}
+}
+
+func TestSetUnion(t *testing.T) {
+	a := New()
+	a.Add(1, 2)
+	b := New()
+	b.Add(2, 3)
+
+	union := a.Union(b)
+
+	if !union.Contains(1, 2, 3) {
+		t.Fatalf("union.Contains(1, 2, 3) = false, got %v", union.Values())
+	}
+	if union.Size() != 3 {
+		t.Fatalf("union.Size() = %d, want 3", union.Size())
+	}
+}
+
+func TestSetPop(t *testing.T) {
+	empty := New()
+	if _, ok := empty.Pop(); ok {
+		t.Fatal("Pop() on empty set returned ok = true, want false")
+	}
+
+	set := New()
+	set.Add(1, 2, 3)
+	item, ok := set.Pop()
+	if !ok {
+		t.Fatal("Pop() returned ok = false, want true")
+	}
+	if set.Contains(item) {
+		t.Fatalf("Pop() did not remove %v from the set", item)
+	}
+	if set.Size() != 2 {
+		t.Fatalf("set.Size() = %d after Pop, want 2", set.Size())
+	}
+}
+
+func TestSetCopy(t *testing.T) {
+	set := New()
+	set.Add(1, 2, 3)
+
+	clone := set.Copy()
+	clone.Add(4)
+	set.Remove(1)
+
+	if clone.Contains(1) == false {
+		t.Fatal("clone lost element 1 after original was mutated")
+	}
+	if set.Contains(4) {
+		t.Fatal("original gained element 4 added only to the clone")
+	}
+}
+
+func TestSetMergeAndSeparate(t *testing.T) {
+	set := New()
+	set.Add(1, 2)
+	other := New()
+	other.Add(2, 3)
+
+	set.Merge(other)
+	if !set.Contains(1, 2, 3) {
+		t.Fatalf("after Merge, set = %v, want {1, 2, 3}", set.Values())
+	}
+
+	set.Separate(other)
+	if !set.Contains(1) || set.Contains(2) || set.Contains(3) {
+		t.Fatalf("after Separate, set = %v, want {1}", set.Values())
+	}
+}
+
+func TestSetIsSuperset(t *testing.T) {
+	set := New()
+	set.Add(1, 2, 3)
+	sub := New()
+	sub.Add(1, 2)
+
+	if !set.IsSuperset(sub) {
+		t.Error("set.IsSuperset(sub) = false, want true")
+	}
+	if sub.IsSuperset(set) {
+		t.Error("sub.IsSuperset(set) = true, want false")
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/emirpasic/gods/sets"
+)
+
+func assertSetTSImplementation() {
+	var _ sets.Set = (*SetTS)(nil)
+}
+
+// SetTS holds elements in go's native map, guarded by a sync.RWMutex so that
+// it may be shared safely across goroutines. It satisfies the same sets.Set
+// interface as Set, so callers can pick the concurrency model that fits
+// their use case without rewriting call sites.
+type SetTS struct {
+	mu    sync.RWMutex
+	items map[interface{}]struct{}
+}
+
+// NewThreadSafe instantiates a new empty thread-safe set.
+func NewThreadSafe() *SetTS {
+	return &SetTS{items: make(map[interface{}]struct{})}
+}
+
+// lockBoth locks a and b for reading in a deterministic order (by memory
+// address) so that two SetTS instances operating on each other concurrently
+// (e.g. a.Union(b) racing with b.Union(a)) can never deadlock. It returns a
+// function that releases both locks in the reverse order.
+func lockBoth(a, b *SetTS) func() {
+	if a == b {
+		a.mu.RLock()
+		return a.mu.RUnlock
+	}
+	if uintptr(unsafe.Pointer(a)) < uintptr(unsafe.Pointer(b)) {
+		a.mu.RLock()
+		b.mu.RLock()
+		return func() { b.mu.RUnlock(); a.mu.RUnlock() }
+	}
+	b.mu.RLock()
+	a.mu.RLock()
+	return func() { a.mu.RUnlock(); b.mu.RUnlock() }
+}
+
+// New returns a new empty thread-safe set, satisfying sets.Set's New()
+// factory method.
+func (set *SetTS) New() sets.Set {
+	return NewThreadSafe()
+}
+
+// Add adds the items (one or more) to the set.
+func (set *SetTS) Add(items ...interface{}) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	for _, item := range items {
+		set.items[item] = itemExists
+	}
+}
+
+// Remove removes the items (one or more) from the set.
+func (set *SetTS) Remove(items ...interface{}) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	for _, item := range items {
+		delete(set.items, item)
+	}
+}
+
+// Contains check if items (one or more) are present in the set.
+// All items have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *SetTS) Contains(items ...interface{}) bool {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for _, item := range items {
+		if _, contains := set.items[item]; !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns whether two sets intersect (i.e. share one or more item).
+func (set *SetTS) Intersect(other sets.Set) bool {
+	if otherTS, ok := other.(*SetTS); ok {
+		unlock := lockBoth(set, otherTS)
+		defer unlock()
+		for k := range set.items {
+			if _, contains := otherTS.items[k]; contains {
+				return true
+			}
+		}
+		return false
+	}
+
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.items {
+		if other.Contains(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Intersection returns the set of elements that are contained in both initial sets.Set.
+func (set *SetTS) Intersection(other sets.Set) sets.Set {
+	inter := NewThreadSafe()
+
+	if otherTS, ok := other.(*SetTS); ok {
+		unlock := lockBoth(set, otherTS)
+		defer unlock()
+		for k := range set.items {
+			if _, contains := otherTS.items[k]; contains {
+				inter.items[k] = itemExists
+			}
+		}
+		return inter
+	}
+
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.items {
+		if other.Contains(k) {
+			inter.items[k] = itemExists
+		}
+	}
+	return inter
+}
+
+// Union returns the set of elements of both sets.
+func (set *SetTS) Union(other sets.Set) sets.Set {
+	union := NewThreadSafe()
+
+	if otherTS, ok := other.(*SetTS); ok {
+		unlock := lockBoth(set, otherTS)
+		defer unlock()
+		for k := range set.items {
+			union.items[k] = itemExists
+		}
+		for k := range otherTS.items {
+			union.items[k] = itemExists
+		}
+		return union
+	}
+
+	set.mu.RLock()
+	for k := range set.items {
+		union.items[k] = itemExists
+	}
+	set.mu.RUnlock()
+	union.Add(other.Values()...)
+	return union
+}
+
+// Subtract returns the set of elements from the first set that are not in the second set.
+func (set *SetTS) Subtract(other sets.Set) sets.Set {
+	diff := NewThreadSafe()
+
+	if otherTS, ok := other.(*SetTS); ok {
+		unlock := lockBoth(set, otherTS)
+		defer unlock()
+		for k := range set.items {
+			if _, contains := otherTS.items[k]; !contains {
+				diff.items[k] = itemExists
+			}
+		}
+		return diff
+	}
+
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for k := range set.items {
+		if !other.Contains(k) {
+			diff.items[k] = itemExists
+		}
+	}
+	return diff
+}
+
+// Pop removes and returns an arbitrary element from the set. The second
+// return value is false if the set was empty.
+func (set *SetTS) Pop() (interface{}, bool) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	for item := range set.items {
+		delete(set.items, item)
+		return item, true
+	}
+	return nil, false
+}
+
+// Copy returns a shallow clone of the set.
+func (set *SetTS) Copy() *SetTS {
+	clone := NewThreadSafe()
+	clone.Add(set.Values()...)
+	return clone
+}
+
+// Merge adds every element of other to the set in place, acting as an
+// in-place union.
+func (set *SetTS) Merge(other sets.Set) {
+	set.Add(other.Values()...)
+}
+
+// Separate removes every element of other from the set in place, acting as
+// an in-place difference.
+func (set *SetTS) Separate(other sets.Set) {
+	set.Remove(other.Values()...)
+}
+
+// Equal returns true if set and other contain exactly the same elements.
+func (set *SetTS) Equal(other sets.Set) bool {
+	return set.Size() == other.Size() && set.IsSubset(other)
+}
+
+// IsSubset returns true if every element of set is present in other.
+func (set *SetTS) IsSubset(other sets.Set) bool {
+	if otherTS, ok := other.(*SetTS); ok {
+		unlock := lockBoth(set, otherTS)
+		defer unlock()
+		for k := range set.items {
+			if _, contains := otherTS.items[k]; !contains {
+				return false
+			}
+		}
+		return true
+	}
+
+	// other is not a *SetTS, so it manages its own locking: take a snapshot
+	// of set's elements instead of holding set.mu across the call to
+	// other.Contains, which would recursively lock set.mu if other == set.
+	for _, k := range set.Values() {
+		if !other.Contains(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if set contains every element of other.
+func (set *SetTS) IsSuperset(other sets.Set) bool {
+	for _, v := range other.Values() {
+		if !set.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *SetTS) Empty() bool {
+	return set.Size() == 0
+}
+
+// Size returns number of elements within the set.
+func (set *SetTS) Size() int {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	return len(set.items)
+}
+
+// Clear clears all values in the set.
+func (set *SetTS) Clear() {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	set.items = make(map[interface{}]struct{})
+}
+
+// Values returns all items in the set.
+func (set *SetTS) Values() []interface{} {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	values := make([]interface{}, len(set.items))
+	count := 0
+	for item := range set.items {
+		values[count] = item
+		count++
+	}
+	return values
+}
+
+// Iterator returns a channel-based iterator over a snapshot of the set's
+// elements taken under a read lock. The producing goroutine leaks if the
+// returned Iterator's C channel is not drained to completion, so callers
+// that stop early must call Stop.
+func (set *SetTS) Iterator() *sets.Iterator {
+	items := set.Values()
+	c := make(chan interface{})
+	stop := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		defer close(c)
+		for _, item := range items {
+			select {
+			case c <- item:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return &sets.Iterator{
+		C:    c,
+		Stop: func() { once.Do(func() { close(stop) }) },
+	}
+}
+
+// Each calls f for every item in the set, stopping early if f returns false.
+// f is called with the lock released, over a snapshot of the set's
+// elements, so it may itself call back into set (e.g. Add or Remove)
+// without deadlocking.
+func (set *SetTS) Each(f func(item interface{}) bool) {
+	for _, item := range set.Values() {
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// Any returns true if f returns true for at least one item in the set. f is
+// called with the lock released, over a snapshot of the set's elements.
+func (set *SetTS) Any(f func(item interface{}) bool) bool {
+	for _, item := range set.Values() {
+		if f(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if f returns true for every item in the set. f is called
+// with the lock released, over a snapshot of the set's elements.
+func (set *SetTS) All(f func(item interface{}) bool) bool {
+	for _, item := range set.Values() {
+		if !f(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a new set containing only the items for which f returns
+// true. f is called with the lock released, over a snapshot of the set's
+// elements.
+func (set *SetTS) Filter(f func(item interface{}) bool) sets.Set {
+	result := NewThreadSafe()
+	for _, item := range set.Values() {
+		if f(item) {
+			result.items[item] = itemExists
+		}
+	}
+	return result
+}
+
+// Map returns a new set containing the result of applying f to every item.
+// f is called with the lock released, over a snapshot of the set's
+// elements.
+func (set *SetTS) Map(f func(item interface{}) interface{}) sets.Set {
+	result := NewThreadSafe()
+	for _, item := range set.Values() {
+		result.items[f(item)] = itemExists
+	}
+	return result
+}
+
+// String returns a string representation of container
+func (set *SetTS) String() string {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	str := "HashSet\n"
+	items := []string{}
+	for k := range set.items {
+		items = append(items, fmt.Sprintf("%v", k))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
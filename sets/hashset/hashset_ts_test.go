@@ -0,0 +1,165 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"testing"
+	"time"
+)
+
+// withTimeout runs fn in a goroutine and fails the test if it doesn't
+// complete within d, catching deadlocks that would otherwise hang the test
+// binary forever.
+func withTimeout(t *testing.T, d time.Duration, fn func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(d):
+		t.Fatal("timed out, likely deadlocked")
+	}
+}
+
+func TestSetTSEachCanMutateSameSet(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+
+	withTimeout(t, 2*time.Second, func() {
+		set.Each(func(item interface{}) bool {
+			set.Add(item.(int) + 100)
+			return true
+		})
+	})
+}
+
+func TestSetTSFilterCanMutateSameSet(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+
+	withTimeout(t, 2*time.Second, func() {
+		set.Filter(func(item interface{}) bool {
+			set.Add(item.(int) + 100)
+			return true
+		})
+	})
+}
+
+func TestSetTSIsSubsetAndEqualSelf(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+
+	withTimeout(t, 2*time.Second, func() {
+		if !set.IsSubset(set) {
+			t.Error("set.IsSubset(set) = false, want true")
+		}
+		if !set.Equal(set) {
+			t.Error("set.Equal(set) = false, want true")
+		}
+	})
+}
+
+func TestSetTSPop(t *testing.T) {
+	empty := NewThreadSafe()
+	if _, ok := empty.Pop(); ok {
+		t.Fatal("Pop() on empty set returned ok = true, want false")
+	}
+
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+	item, ok := set.Pop()
+	if !ok {
+		t.Fatal("Pop() returned ok = false, want true")
+	}
+	if set.Contains(item) {
+		t.Fatalf("Pop() did not remove %v from the set", item)
+	}
+	if set.Size() != 2 {
+		t.Fatalf("set.Size() = %d after Pop, want 2", set.Size())
+	}
+}
+
+func TestSetTSCopy(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+
+	clone := set.Copy()
+	clone.Add(4)
+	set.Remove(1)
+
+	if !clone.Contains(1) {
+		t.Fatal("clone lost element 1 after original was mutated")
+	}
+	if set.Contains(4) {
+		t.Fatal("original gained element 4 added only to the clone")
+	}
+}
+
+func TestSetTSMergeAndSeparate(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2)
+	other := NewThreadSafe()
+	other.Add(2, 3)
+
+	set.Merge(other)
+	if !set.Contains(1, 2, 3) {
+		t.Fatalf("after Merge, set = %v, want {1, 2, 3}", set.Values())
+	}
+
+	set.Separate(other)
+	if !set.Contains(1) || set.Contains(2) || set.Contains(3) {
+		t.Fatalf("after Separate, set = %v, want {1}", set.Values())
+	}
+}
+
+func TestSetTSIsSuperset(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+	sub := NewThreadSafe()
+	sub.Add(1, 2)
+
+	if !set.IsSuperset(sub) {
+		t.Error("set.IsSuperset(sub) = false, want true")
+	}
+	if sub.IsSuperset(set) {
+		t.Error("sub.IsSuperset(set) = true, want false")
+	}
+}
+
+// TestSetTSIsSubsetSelfUnderContention reproduces the deadlock from holding
+// set.mu.RLock() across a recursive call to set.Contains when IsSubset is
+// called with itself as the argument while another goroutine writes to the
+// set: readers queued behind a writer can't proceed, but the outer RLock
+// holder can't finish without its own nested RLock succeeding.
+func TestSetTSIsSubsetSelfUnderContention(t *testing.T) {
+	set := NewThreadSafe()
+	set.Add(1, 2, 3)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+				set.Add(i)
+			}
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		withTimeout(t, 2*time.Second, func() {
+			set.IsSubset(set)
+		})
+	}
+
+	close(stop)
+	<-done
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringSet(t *testing.T) {
+	s := NewStringSet("b", "a", "c")
+
+	if !s.Has("a") || s.Has("z") {
+		t.Fatalf("Has: got s.Has(a)=%v, s.Has(z)=%v", s.Has("a"), s.Has("z"))
+	}
+	if !s.HasAll("a", "b") || s.HasAll("a", "z") {
+		t.Fatalf("HasAll returned unexpected result")
+	}
+	if !s.HasAny("a", "z") || s.HasAny("y", "z") {
+		t.Fatalf("HasAny returned unexpected result")
+	}
+
+	s.Insert("d")
+	if !s.Has("d") {
+		t.Fatalf("Insert did not add d")
+	}
+	s.Delete("d")
+	if s.Has("d") {
+		t.Fatalf("Delete did not remove d")
+	}
+
+	other := NewStringSet("b", "c", "e")
+	if got := s.Difference(other).List(); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Fatalf("Difference = %v, want [a]", got)
+	}
+	if got := s.Union(other).List(); !reflect.DeepEqual(got, []string{"a", "b", "c", "e"}) {
+		t.Fatalf("Union = %v, want [a b c e]", got)
+	}
+	if got := s.Intersection(other).List(); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Fatalf("Intersection = %v, want [b c]", got)
+	}
+
+	if got := s.List(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("List = %v, want sorted [a b c]", got)
+	}
+	if s.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", s.Len())
+	}
+}
+
+func TestIntSet(t *testing.T) {
+	s := NewIntSet(3, 1, 2)
+
+	if !s.Has(1) || s.Has(9) {
+		t.Fatalf("Has: got s.Has(1)=%v, s.Has(9)=%v", s.Has(1), s.Has(9))
+	}
+	if !s.HasAll(1, 2) || s.HasAll(1, 9) {
+		t.Fatalf("HasAll returned unexpected result")
+	}
+	if !s.HasAny(1, 9) || s.HasAny(8, 9) {
+		t.Fatalf("HasAny returned unexpected result")
+	}
+
+	s.Insert(4)
+	if !s.Has(4) {
+		t.Fatalf("Insert did not add 4")
+	}
+	s.Delete(4)
+	if s.Has(4) {
+		t.Fatalf("Delete did not remove 4")
+	}
+
+	other := NewIntSet(2, 3, 5)
+	if got := s.Difference(other).List(); !reflect.DeepEqual(got, []int{1}) {
+		t.Fatalf("Difference = %v, want [1]", got)
+	}
+	if got := s.Union(other).List(); !reflect.DeepEqual(got, []int{1, 2, 3, 5}) {
+		t.Fatalf("Union = %v, want [1 2 3 5]", got)
+	}
+	if got := s.Intersection(other).List(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Fatalf("Intersection = %v, want [2 3]", got)
+	}
+
+	if got := s.List(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("List = %v, want sorted [1 2 3]", got)
+	}
+	if s.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", s.Len())
+	}
+}
+
+func TestInt64Set(t *testing.T) {
+	s := NewInt64Set(30, 10, 20)
+
+	if !s.Has(10) || s.Has(90) {
+		t.Fatalf("Has: got s.Has(10)=%v, s.Has(90)=%v", s.Has(10), s.Has(90))
+	}
+	if !s.HasAll(10, 20) || s.HasAll(10, 90) {
+		t.Fatalf("HasAll returned unexpected result")
+	}
+	if !s.HasAny(10, 90) || s.HasAny(80, 90) {
+		t.Fatalf("HasAny returned unexpected result")
+	}
+
+	s.Insert(40)
+	if !s.Has(40) {
+		t.Fatalf("Insert did not add 40")
+	}
+	s.Delete(40)
+	if s.Has(40) {
+		t.Fatalf("Delete did not remove 40")
+	}
+
+	other := NewInt64Set(20, 30, 50)
+	if got := s.Difference(other).List(); !reflect.DeepEqual(got, []int64{10}) {
+		t.Fatalf("Difference = %v, want [10]", got)
+	}
+	if got := s.Union(other).List(); !reflect.DeepEqual(got, []int64{10, 20, 30, 50}) {
+		t.Fatalf("Union = %v, want [10 20 30 50]", got)
+	}
+	if got := s.Intersection(other).List(); !reflect.DeepEqual(got, []int64{20, 30}) {
+		t.Fatalf("Intersection = %v, want [20 30]", got)
+	}
+
+	if got := s.List(); !reflect.DeepEqual(got, []int64{10, 20, 30}) {
+		t.Fatalf("List = %v, want sorted [10 20 30]", got)
+	}
+	if s.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", s.Len())
+	}
+}
+
+func TestByteSet(t *testing.T) {
+	s := NewByteSet('c', 'a', 'b')
+
+	if !s.Has('a') || s.Has('z') {
+		t.Fatalf("Has: got s.Has(a)=%v, s.Has(z)=%v", s.Has('a'), s.Has('z'))
+	}
+	if !s.HasAll('a', 'b') || s.HasAll('a', 'z') {
+		t.Fatalf("HasAll returned unexpected result")
+	}
+	if !s.HasAny('a', 'z') || s.HasAny('y', 'z') {
+		t.Fatalf("HasAny returned unexpected result")
+	}
+
+	s.Insert('d')
+	if !s.Has('d') {
+		t.Fatalf("Insert did not add d")
+	}
+	s.Delete('d')
+	if s.Has('d') {
+		t.Fatalf("Delete did not remove d")
+	}
+
+	other := NewByteSet('b', 'c', 'e')
+	if got := s.Difference(other).List(); !reflect.DeepEqual(got, []byte{'a'}) {
+		t.Fatalf("Difference = %v, want [a]", got)
+	}
+	if got := s.Union(other).List(); !reflect.DeepEqual(got, []byte{'a', 'b', 'c', 'e'}) {
+		t.Fatalf("Union = %v, want [a b c e]", got)
+	}
+	if got := s.Intersection(other).List(); !reflect.DeepEqual(got, []byte{'b', 'c'}) {
+		t.Fatalf("Intersection = %v, want [b c]", got)
+	}
+
+	if got := s.List(); !reflect.DeepEqual(got, []byte{'a', 'b', 'c'}) {
+		t.Fatalf("List = %v, want sorted [a b c]", got)
+	}
+	if s.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", s.Len())
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sets provides an abstract Set interface.
+//
+// In computer science, a set is an abstract data type that can store unique
+// values, without any particular order.
+//
+// Reference: http://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
+package sets
+
+import "github.com/emirpasic/gods/containers"
+
+// Set interface that all sets implement. Concrete implementations (e.g.
+// hashset.Set, hashset.SetTS) are free to choose their own concurrency
+// model; callers that only depend on Set can mix and match them.
+type Set interface {
+	// New returns a new empty set of the same concrete type as the
+	// receiver, letting generic algorithms (see Union, Intersection, etc.)
+	// produce siblings without knowing the concrete type.
+	New() Set
+
+	Add(elements ...interface{})
+	Remove(elements ...interface{})
+	Contains(elements ...interface{}) bool
+
+	Intersect(another Set) bool
+	Intersection(another Set) Set
+	Union(another Set) Set
+	Subtract(another Set) Set
+
+	Iterator() *Iterator
+	Each(f func(item interface{}) bool)
+	Any(f func(item interface{}) bool) bool
+	All(f func(item interface{}) bool) bool
+	Filter(f func(item interface{}) bool) Set
+	Map(f func(item interface{}) interface{}) Set
+
+	Pop() (interface{}, bool)
+	Merge(another Set)
+	Separate(another Set)
+	Equal(another Set) bool
+	IsSubset(another Set) bool
+	IsSuperset(another Set) bool
+
+	containers.Container
+}
+
+// Iterator is a stoppable channel-based iterator over a Set's elements. C
+// yields each element in turn; consumers that stop consuming before C is
+// drained must call Stop to release the producing goroutine.
+type Iterator struct {
+	C    <-chan interface{}
+	Stop func()
+}